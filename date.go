@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"database/sql/driver"
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
@@ -49,6 +50,41 @@ func (date Date) Equals(other Date) bool {
 	return date.Time.Equal(other.Time)
 }
 
+// Compare compares the date with another, returning -1 if the date is
+// before other, 1 if it is after other, and 0 if they are equal. It is
+// suitable for use with sort.Slice.
+func (date Date) Compare(other Date) int {
+	switch {
+	case date.Before(other):
+		return -1
+	case date.After(other):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Sub returns the number of days between the date and other. The
+// result is negative if other is after the date.
+func (date Date) Sub(other Date) int {
+	return int(date.Time.Sub(other.Time).Hours() / 24)
+}
+
+// In projects the date into the given location, returning midnight on
+// that day in that location
+func (date Date) In(loc *time.Location) time.Time {
+	return time.Date(
+		date.Time.Year(), date.Time.Month(), date.Time.Day(),
+		0, 0, 0, 0, loc,
+	)
+}
+
+// IsValid returns true if the date was constructed with a zero time of
+// day in UTC, as New, FromTime, and Parse all do
+func (date Date) IsValid() bool {
+	return date.Time.Location() == time.UTC && date.Time.Truncate(24*time.Hour).Equal(date.Time)
+}
+
 // UnmarshalJSON converts a byte array into a Date
 func (date *Date) UnmarshalJSON(text []byte) error {
 	if string(text) == "null" {
@@ -63,11 +99,11 @@ func (date *Date) UnmarshalJSON(text []byte) error {
 	if err := dec.Decode(&s); err != nil {
 		return err
 	}
-	value, err := time.Parse(ISO8601Date, s)
+	value, err := ParseUsingDefaultLayouts(s)
 	if err != nil {
 		return err
 	}
-	date.Time = value
+	date.Time = value.Time
 	return nil
 }
 
@@ -80,12 +116,63 @@ func (date Date) MarshalJSON() ([]byte, error) {
 	return []byte(`"` + date.format() + `"`), nil
 }
 
-// Scan converts an SQL value into a Date
-func (date *Date) Scan(value interface{}) error {
-	date.Time = value.(time.Time)
+// MarshalText returns the Date formatted as ISO 8601, satisfying
+// encoding.TextMarshaler. It lets a Date be used as a JSON object key
+// and plugs into encoding/xml attributes, yaml.v3, and url.Values
+func (date Date) MarshalText() ([]byte, error) {
+	return []byte(date.format()), nil
+}
+
+// AppendText appends the Date formatted as ISO 8601, satisfying
+// encoding.TextAppender. It shadows the RFC 3339 AppendText promoted
+// from the embedded time.Time, so encoders that prefer AppendText over
+// MarshalText (such as encoding/json for map keys) also get ISO 8601
+func (date Date) AppendText(b []byte) ([]byte, error) {
+	return date.Time.AppendFormat(b, ISO8601Date), nil
+}
+
+// UnmarshalText parses an ISO 8601 formatted Date, satisfying
+// encoding.TextUnmarshaler
+func (date *Date) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*date = parsed
 	return nil
 }
 
+// Scan converts an SQL value into a Date. The driver may return a
+// time.Time, a string, or a []byte, depending on the database and
+// driver in use
+func (date *Date) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case time.Time:
+		date.Time = v
+		return nil
+	case []byte:
+		parsed, err := ParseUsingDefaultLayouts(string(v))
+		if err != nil {
+			return err
+		}
+		*date = parsed
+		return nil
+	case string:
+		parsed, err := ParseUsingDefaultLayouts(v)
+		if err != nil {
+			return err
+		}
+		*date = parsed
+		return nil
+	case nil:
+		var zero Date
+		*date = zero
+		return nil
+	default:
+		return fmt.Errorf("date: unsupported Scan type %T", value)
+	}
+}
+
 // Value returns the date formatted for insert into PostgreSQL
 func (date Date) Value() (driver.Value, error) {
 	return date.format(), nil
@@ -117,6 +204,12 @@ func FromTime(t time.Time) Date {
 	return New(t.Date())
 }
 
+// DateOf is an alias for FromTime, matching the naming used by
+// civil.DateOf in cloud.google.com/go/civil
+func DateOf(t time.Time) Date {
+	return FromTime(t)
+}
+
 // New creates a new Date
 func New(year int, month time.Month, day int) Date {
 	// Remove all second and nano second information and mark as UTC
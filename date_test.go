@@ -61,6 +61,168 @@ func TestDate(t *testing.T) {
 	}
 }
 
+func TestSetDefaultLayouts(t *testing.T) {
+	// Restore the package defaults once this test is done so other
+	// tests are not affected by the order they run in
+	defer SetDefaultLayouts([]string{"iso8601"})
+
+	if err := SetDefaultLayouts([]string{"us", "iso8601"}); err != nil {
+		t.Fatal("SetDefaultLayouts with registered names should not error")
+	}
+
+	var us Date
+	if err := us.UnmarshalJSON([]byte(`"03/01/2015"`)); err != nil {
+		t.Error("UnmarshalJSON should accept a US formatted date")
+	}
+	if !us.Equals(New(2015, 3, 1)) {
+		t.Error("UnmarshalJSON of a US formatted date should produce the expected date")
+	}
+
+	var iso Date
+	if err := iso.UnmarshalJSON([]byte(`"2015-03-01"`)); err != nil {
+		t.Error("UnmarshalJSON should still accept an ISO 8601 date")
+	}
+	if !iso.Equals(New(2015, 3, 1)) {
+		t.Error("UnmarshalJSON of an ISO 8601 date should produce the expected date")
+	}
+
+	if err := SetDefaultLayouts([]string{"unregistered"}); err == nil {
+		t.Error("SetDefaultLayouts with an unregistered name should error")
+	}
+}
+
+func TestDate_MarshalText(t *testing.T) {
+	day := New(2015, 3, 1)
+	text, err := day.MarshalText()
+	if err != nil {
+		t.Fatal("MarshalText should not error")
+	}
+	if string(text) != "2015-03-01" {
+		t.Errorf(`MarshalText of March 1st should be "2015-03-01", got %q`, text)
+	}
+
+	var parsed Date
+	if err := parsed.UnmarshalText(text); err != nil {
+		t.Fatal("UnmarshalText should not error")
+	}
+	if !parsed.Equals(day) {
+		t.Error("UnmarshalText should round-trip with MarshalText")
+	}
+}
+
+func TestDate_MapKey(t *testing.T) {
+	m := map[Date]bool{New(2018, 12, 12): true}
+	output, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal("json.Marshal of a map keyed by Date should not error")
+	}
+	if string(output) != `{"2018-12-12":true}` {
+		t.Errorf(`json.Marshal of the map should be {"2018-12-12":true}, got %s`, output)
+	}
+
+	var parsed map[Date]bool
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		t.Fatal("json.Unmarshal of a map keyed by Date should not error")
+	}
+	if !parsed[New(2018, 12, 12)] {
+		t.Error("json.Unmarshal should produce the expected Date key")
+	}
+}
+
+func TestDate_Compare(t *testing.T) {
+	march1 := New(2015, 3, 1)
+	march2 := New(2015, 3, 2)
+
+	if march1.Compare(march2) != -1 {
+		t.Error("March 1st should compare before March 2nd")
+	}
+	if march2.Compare(march1) != 1 {
+		t.Error("March 2nd should compare after March 1st")
+	}
+	if march1.Compare(march1) != 0 {
+		t.Error("March 1st should compare equal to itself")
+	}
+}
+
+func TestDate_Sub(t *testing.T) {
+	march1 := New(2015, 3, 1)
+	march3 := New(2015, 3, 3)
+
+	if march3.Sub(march1) != 2 {
+		t.Error("March 3rd minus March 1st should be 2 days")
+	}
+	if march1.Sub(march3) != -2 {
+		t.Error("March 1st minus March 3rd should be -2 days")
+	}
+}
+
+func TestDate_In(t *testing.T) {
+	day := New(2015, 3, 1)
+	est, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	local := day.In(est)
+	if local.Location() != est {
+		t.Error("In should project the date into the given location")
+	}
+	if local.Year() != 2015 || local.Month() != 3 || local.Day() != 1 {
+		t.Error("In should preserve the year, month, and day")
+	}
+}
+
+func TestDate_IsValid(t *testing.T) {
+	if !New(2015, 3, 1).IsValid() {
+		t.Error("A date created with New should be valid")
+	}
+	if !FromTime(time.Now()).IsValid() {
+		t.Error("A date created with FromTime should be valid")
+	}
+	invalid := Date{Time: time.Date(2015, 3, 1, 12, 30, 0, 0, time.UTC)}
+	if invalid.IsValid() {
+		t.Error("A date with a non-zero time of day should not be valid")
+	}
+}
+
+func TestDate_Scan(t *testing.T) {
+	var fromTime Date
+	if err := fromTime.Scan(time.Date(2015, 3, 1, 0, 0, 0, 0, time.UTC)); err != nil {
+		t.Error("Scan of a time.Time should not error")
+	}
+	if fromTime.String() != "2015-03-01" {
+		t.Error("Scan of a time.Time should produce the expected date")
+	}
+
+	var fromBytes Date
+	if err := fromBytes.Scan([]byte("2015-03-01")); err != nil {
+		t.Error("Scan of a []byte should not error")
+	}
+	if fromBytes.String() != "2015-03-01" {
+		t.Error("Scan of a []byte should produce the expected date")
+	}
+
+	var fromString Date
+	if err := fromString.Scan("2015-03-01"); err != nil {
+		t.Error("Scan of a string should not error")
+	}
+	if fromString.String() != "2015-03-01" {
+		t.Error("Scan of a string should produce the expected date")
+	}
+
+	var fromNil Date
+	if err := fromNil.Scan(nil); err != nil {
+		t.Error("Scan of nil should not error")
+	}
+	if !fromNil.IsZero() {
+		t.Error("Scan of nil should produce a zero date")
+	}
+
+	var fromInvalid Date
+	if fromInvalid.Scan(42) == nil {
+		t.Error("Scan of an unsupported type should error")
+	}
+}
+
 func TestDate_Within(t *testing.T) {
 	march1 := New(2015, 3, 1)
 	dec1 := New(2015, 12, 1)
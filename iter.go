@@ -0,0 +1,68 @@
+package date
+
+import "fmt"
+
+// Iterator is a pull-based cursor over the days in a Range. Each call
+// to the function returns the next Date and true, or a zero Date and
+// false once the range is exhausted. There is no background work to
+// leak - stopping early (e.g. breaking out of a loop) costs nothing.
+type Iterator func() (Date, bool)
+
+// Iter returns an Iterator over every day in the range, in order,
+// starting with Start and ending with End (inclusive). The range must
+// be bounded - iterating an unbounded range returns an error.
+func (term Range) Iter() (Iterator, error) {
+	return term.IterStep(1)
+}
+
+// IterStep returns an Iterator over every nth day in the range,
+// starting with Start. The range must be bounded and days must be
+// positive.
+func (term Range) IterStep(days int) (Iterator, error) {
+	if days <= 0 {
+		return nil, fmt.Errorf("date: step must be a positive number of days")
+	}
+	if term.IsEmpty() {
+		return func() (Date, bool) { return Date{}, false }, nil
+	}
+	if term.Start.IsZero() || term.End.IsZero() {
+		return nil, fmt.Errorf("date: cannot iterate an unbounded range")
+	}
+	current := term.Start
+	return func() (Date, bool) {
+		if current.After(term.End) {
+			return Date{}, false
+		}
+		d := current
+		current = current.AddDays(days)
+		return d, true
+	}, nil
+}
+
+// IterWeeks returns an Iterator over the same day of the week,
+// starting with Start, for every week in the range.
+func (term Range) IterWeeks() (Iterator, error) {
+	return term.IterStep(7)
+}
+
+// IterMonths returns an Iterator that starts with Start and advances
+// one month at a time with AddDate until it passes End. A Start day
+// that a later month lacks (e.g. the 31st) rolls over into the
+// following month, so that month is skipped and later days drift.
+func (term Range) IterMonths() (Iterator, error) {
+	if term.IsEmpty() {
+		return func() (Date, bool) { return Date{}, false }, nil
+	}
+	if term.Start.IsZero() || term.End.IsZero() {
+		return nil, fmt.Errorf("date: cannot iterate an unbounded range")
+	}
+	current := term.Start
+	return func() (Date, bool) {
+		if current.After(term.End) {
+			return Date{}, false
+		}
+		d := current
+		current = current.AddDate(0, 1, 0)
+		return d, true
+	}, nil
+}
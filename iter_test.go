@@ -0,0 +1,91 @@
+package date
+
+import (
+	"runtime"
+	"testing"
+)
+
+func TestRange_Iter(t *testing.T) {
+	term := EntireMonth(2015, 3)
+	next, err := term.Iter()
+	if err != nil {
+		t.Fatal("Iter of a bounded range should not error")
+	}
+	count := 0
+	for d, ok := next(); ok; d, ok = next() {
+		if !d.Within(term) {
+			t.Errorf("Iter yielded %s which is not within %s", d, term)
+		}
+		count++
+	}
+	if count != 31 {
+		t.Errorf("Iter of March 2015 should yield 31 days, got %d", count)
+	}
+
+	if _, err := Forever().Iter(); err == nil {
+		t.Error("Iter of an unbounded range should error")
+	}
+
+	next, err = Empty().Iter()
+	if err != nil {
+		t.Fatal("Iter of an empty range should not error")
+	}
+	if _, ok := next(); ok {
+		t.Error("Iter of an empty range should yield no days")
+	}
+}
+
+func TestRange_IterStep(t *testing.T) {
+	term := EntireMonth(2015, 3)
+	next, err := term.IterStep(7)
+	if err != nil {
+		t.Fatal("IterStep of a bounded range should not error")
+	}
+	count := 0
+	for _, ok := next(); ok; _, ok = next() {
+		count++
+	}
+	if count != 5 {
+		t.Errorf("IterStep(7) of March 2015 should yield 5 days, got %d", count)
+	}
+
+	if _, err := term.IterStep(0); err == nil {
+		t.Error("IterStep with a non-positive step should error")
+	}
+}
+
+func TestRange_IterMonths(t *testing.T) {
+	term := EntireYear(2015)
+	next, err := term.IterMonths()
+	if err != nil {
+		t.Fatal("IterMonths of a bounded range should not error")
+	}
+	count := 0
+	for _, ok := next(); ok; _, ok = next() {
+		count++
+	}
+	if count != 12 {
+		t.Errorf("IterMonths of 2015 should yield 12 months, got %d", count)
+	}
+}
+
+// TestRange_IterEarlyExit guards against a background goroutine being
+// used to drive the iterator - stopping after the first value, as
+// `for d := range ...; break` style usage would, must not leak
+func TestRange_IterEarlyExit(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	for i := 0; i < 20; i++ {
+		next, err := EntireYear(2015).Iter()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Read a single day and stop, as a caller that `break`s early would
+		next()
+	}
+
+	after := runtime.NumGoroutine()
+	if after > before {
+		t.Errorf("Iter should not leak goroutines on early exit: before=%d after=%d", before, after)
+	}
+}
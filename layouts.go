@@ -0,0 +1,70 @@
+package date
+
+import (
+	"fmt"
+	"sync"
+)
+
+// USDate is a layout for the common US date format
+const USDate = "01/02/2006"
+
+// CompactDate is a layout for dates with no separators, e.g. 20060102
+const CompactDate = "20060102"
+
+var (
+	layoutsMu      sync.RWMutex
+	layouts        = map[string]string{}
+	defaultLayouts = []string{ISO8601Date}
+)
+
+func init() {
+	RegisterLayout("iso8601", ISO8601Date)
+	RegisterLayout("us", USDate)
+	RegisterLayout("compact", CompactDate)
+}
+
+// RegisterLayout associates a name with a time.Parse layout string so
+// it can be referenced later by SetDefaultLayouts
+func RegisterLayout(name, layout string) {
+	layoutsMu.Lock()
+	defer layoutsMu.Unlock()
+	layouts[name] = layout
+}
+
+// SetDefaultLayouts replaces the layouts that UnmarshalJSON and Scan
+// try, in order, when parsing a date string. Each name must have
+// already been registered with RegisterLayout
+func SetDefaultLayouts(names []string) error {
+	layoutsMu.Lock()
+	defer layoutsMu.Unlock()
+
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		layout, ok := layouts[name]
+		if !ok {
+			return fmt.Errorf("date: layout %q is not registered", name)
+		}
+		resolved = append(resolved, layout)
+	}
+	defaultLayouts = resolved
+	return nil
+}
+
+// ParseUsingDefaultLayouts tries each of the default layouts in order,
+// returning the first successful parse
+func ParseUsingDefaultLayouts(value string) (Date, error) {
+	layoutsMu.RLock()
+	tried := make([]string, len(defaultLayouts))
+	copy(tried, defaultLayouts)
+	layoutsMu.RUnlock()
+
+	var lastErr error
+	for _, layout := range tried {
+		date, err := ParseUsingLayout(layout, value)
+		if err == nil {
+			return date, nil
+		}
+		lastErr = err
+	}
+	return Date{}, lastErr
+}
@@ -10,11 +10,28 @@ import (
 
 // Range is a start and end date
 type Range struct {
-	Start   Date `json:"start"`
-	End     Date `json:"end"`
+	Start   Date   `json:"start"`
+	End     Date   `json:"end"`
+	Bounds  Bounds `json:"-"`
 	isEmpty bool
 }
 
+// Bounds controls how Range.Value interprets the End field when
+// formatting a PostgreSQL daterange literal. Scan is unaffected - it
+// always normalizes whatever bound markers it reads to an inclusive
+// End.
+type Bounds int
+
+const (
+	// Inclusive treats End as the last day included in the range.
+	// This is the default, and Value() emits the canonical half-open
+	// "[start,end)" literal by advancing End by one day.
+	Inclusive Bounds = iota
+	// HalfOpen treats End as already being the exclusive upper bound,
+	// so Value() emits it unchanged.
+	HalfOpen
+)
+
 // Contains returns true if the given range is entirely within the
 // the range - inclusive
 func (term Range) Contains(other Range) bool {
@@ -73,13 +90,27 @@ func isEmptyRange(value string) bool {
 	return strings.ToLower(value) == "empty"
 }
 
-// splitRange divides a term into start and end date strings
-func splitRange(value string) (string, string, error) {
+// splitRange divides a term into its start and end bound markers and
+// date strings, e.g. "[2015-03-01,2015-03-02)" splits into
+// '[', "2015-03-01", "2015-03-02", ')'
+func splitRange(value string) (startBound byte, start, end string, endBound byte, err error) {
 	p := strings.SplitN(value, ",", 2)
-	if len(p) != 2 || p[0] == "" || p[1] == "" {
-		return "", "", fmt.Errorf("date: failed to parse date range '%s'", value)
+	if len(p) != 2 || len(p[0]) < 1 || len(p[1]) < 1 {
+		err = fmt.Errorf("date: failed to parse date range '%s'", value)
+		return
+	}
+	startBound, endBound = p[0][0], p[1][len(p[1])-1]
+	if startBound != '[' && startBound != '(' {
+		err = fmt.Errorf("date: invalid range start bound in '%s'", value)
+		return
 	}
-	return strings.ToLower(p[0][1:]), strings.ToLower(p[1][:len(p[1])-1]), nil
+	if endBound != ']' && endBound != ')' {
+		err = fmt.Errorf("date: invalid range end bound in '%s'", value)
+		return
+	}
+	start = strings.ToLower(p[0][1:])
+	end = strings.ToLower(p[1][:len(p[1])-1])
+	return
 }
 
 // Scan converts the given database value to a Range,
@@ -101,33 +132,39 @@ func (term *Range) Scan(value interface{}) error {
 		return nil
 	}
 
-	// Otherwise, parse the given SQL date range
-	start, end, err := splitRange(string(b))
+	// Otherwise, parse the given SQL date range, handling both the
+	// inclusive "[]" and exclusive "()" bound markers on either side
+	startBound, start, end, endBound, err := splitRange(string(b))
 	if err != nil {
 		return err
 	}
 
-	if start == "infinity" || start == "" {
-		// do nothing
+	if start == "" || start == "-infinity" {
+		// Unbounded start
 	} else {
-		var startDate Date
-		if startDate, err = Parse(start); err != nil {
+		startDate, err := Parse(start)
+		if err != nil {
 			return err
 		}
+		if startBound == '(' {
+			// Exclusive start - advance to the first included day
+			startDate = startDate.AddDays(1)
+		}
 		term.Start = startDate
 	}
 
-	if end == "infinity" || end == "" {
+	if end == "" || end == "infinity" {
 		return nil
 	}
 
-	var endDate Date
-	if endDate, err = Parse(end); err != nil {
+	endDate, err := Parse(end)
+	if err != nil {
 		return err
 	}
-
-	// Remove a single day from the date (it is exclusive - we want inclusive)
-	endDate = endDate.AddDays(-1)
+	if endBound == ')' {
+		// Exclusive end - step back to the last included day
+		endDate = endDate.AddDays(-1)
+	}
 	term.End = endDate
 	return nil
 }
@@ -233,18 +270,32 @@ func (term Range) Union(other Range) (union Range) {
 	return
 }
 
-// Value prepares the nullable term for the database
+// Value prepares the term for the database, emitting the canonical
+// PostgreSQL daterange literal "[start,end)". An empty term is
+// emitted as the literal token "empty"; unbounded sides are emitted
+// as "-infinity" and "infinity". Set Bounds to HalfOpen if End
+// already holds the exclusive upper bound - otherwise End is treated
+// as inclusive and advanced by one day
 func (term Range) Value() (driver.Value, error) {
-	if term.IsZero() {
-		return "[,]", nil
+	if term.IsEmpty() {
+		return "empty", nil
 	}
-	if term.Start.IsZero() {
-		return fmt.Sprintf("[,'%s']", term.End), nil
+
+	start := "-infinity"
+	if !term.Start.IsZero() {
+		start = term.Start.String()
 	}
-	if term.End.IsZero() {
-		return fmt.Sprintf("['%s',]", term.Start), nil
+
+	end := "infinity"
+	if !term.End.IsZero() {
+		if term.Bounds == HalfOpen {
+			end = term.End.String()
+		} else {
+			end = term.End.AddDays(1).String()
+		}
 	}
-	return fmt.Sprintf("['%s','%s']", term.Start, term.End), nil
+
+	return fmt.Sprintf("[%s,%s)", start, end), nil
 }
 
 // Empty creates an empty Range
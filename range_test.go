@@ -227,6 +227,105 @@ func TestRange_Union(t *testing.T) {
 	}
 }
 
+// mockRows simulates the []byte values a database/sql driver hands to
+// Scan for a daterange column
+type mockRows []string
+
+func (rows mockRows) scanAll(t *testing.T) []Range {
+	terms := make([]Range, len(rows))
+	for i, row := range rows {
+		if err := terms[i].Scan([]byte(row)); err != nil {
+			t.Fatalf("Scan of %q should not error: %s", row, err)
+		}
+	}
+	return terms
+}
+
+func TestRange_Scan(t *testing.T) {
+	rows := mockRows{
+		"[2015-03-01,2015-03-03)",
+		"(2015-03-01,2015-03-03)",
+		"[2015-03-01,2015-03-03]",
+		"[,2015-03-03)",
+		"[-infinity,infinity)",
+		"empty",
+	}
+	terms := rows.scanAll(t)
+
+	if terms[0] != NewRange(New(2015, 3, 1), New(2015, 3, 2)) {
+		t.Errorf("Scan of a half-open range should be inclusive, got %v", terms[0])
+	}
+	if terms[1] != NewRange(New(2015, 3, 2), New(2015, 3, 2)) {
+		t.Errorf("Scan of an exclusive start should advance a day, got %v", terms[1])
+	}
+	if terms[2] != NewRange(New(2015, 3, 1), New(2015, 3, 3)) {
+		t.Errorf("Scan of an inclusive end should not be adjusted, got %v", terms[2])
+	}
+	if !terms[3].Start.IsZero() || terms[3].End != New(2015, 3, 2) {
+		t.Errorf("Scan of an unbounded start should leave Start zero, got %v", terms[3])
+	}
+	if !terms[4].IsZero() || terms[4].IsEmpty() {
+		t.Errorf("Scan of [-infinity,infinity) should be Forever, got %v", terms[4])
+	}
+	if !terms[5].IsEmpty() {
+		t.Errorf("Scan of 'empty' should be Empty, got %v", terms[5])
+	}
+}
+
+func TestRange_Value(t *testing.T) {
+	valueTests := []struct {
+		term Range
+		want string
+	}{
+		{Never(), "empty"},
+		{Forever(), "[-infinity,infinity)"},
+		{NewRange(New(2015, 3, 1), New(2015, 3, 3)), "[2015-03-01,2015-03-04)"},
+		{Range{Start: New(2015, 3, 1)}, "[2015-03-01,infinity)"},
+		{Range{End: New(2015, 3, 3)}, "[-infinity,2015-03-04)"},
+	}
+	for _, test := range valueTests {
+		have, err := test.term.Value()
+		if err != nil {
+			t.Fatalf("Value() of %v should not error: %s", test.term, err)
+		}
+		if have != test.want {
+			t.Errorf("Value() of %v want=%s have=%s", test.term, test.want, have)
+		}
+	}
+
+	halfOpen := NewRange(New(2015, 3, 1), New(2015, 3, 4))
+	halfOpen.Bounds = HalfOpen
+	have, err := halfOpen.Value()
+	if err != nil {
+		t.Fatalf("Value() of a HalfOpen range should not error: %s", err)
+	}
+	if have != "[2015-03-01,2015-03-04)" {
+		t.Errorf("Value() of a HalfOpen range should leave End unchanged, got %s", have)
+	}
+}
+
+func TestRange_ScanValueRoundTrip(t *testing.T) {
+	// A term scanned from Postgres' canonical "[start,end)" output
+	// should Value() back to the same literal
+	for _, raw := range []string{
+		"[2015-03-01,2015-03-04)",
+		"[-infinity,infinity)",
+		"empty",
+	} {
+		var term Range
+		if err := term.Scan([]byte(raw)); err != nil {
+			t.Fatalf("Scan of %q should not error: %s", raw, err)
+		}
+		value, err := term.Value()
+		if err != nil {
+			t.Fatalf("Value() of %q should not error: %s", raw, err)
+		}
+		if value != raw {
+			t.Errorf("round-trip of %q produced %v", raw, value)
+		}
+	}
+}
+
 func TestRange_Unmarshal(t *testing.T) {
 	// Unmarshaling should overwrite values
 	open := EntireMonth(2015, 2)
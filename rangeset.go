@@ -0,0 +1,202 @@
+package date
+
+import "sort"
+
+// RangeSet is a sorted set of non-overlapping, non-adjacent Ranges.
+// The zero value is an empty set. A non-empty RangeSet marshals to
+// and from JSON as a plain array of Ranges; like Date and Range, an
+// empty set marshals to null
+type RangeSet []Range
+
+// startBefore compares two Start bounds, treating a zero Date as
+// unbounded (-infinity)
+func startBefore(a, b Date) bool {
+	if a.IsZero() {
+		return !b.IsZero()
+	}
+	if b.IsZero() {
+		return false
+	}
+	return a.Before(b)
+}
+
+// endAfter compares two End bounds, treating a zero Date as
+// unbounded (+infinity)
+func endAfter(a, b Date) bool {
+	if a.IsZero() {
+		return !b.IsZero()
+	}
+	if b.IsZero() {
+		return false
+	}
+	return a.After(b)
+}
+
+// touches returns true if the two ranges overlap or are adjacent, i.e.
+// a Union of the two would leave no gap between them. It assumes a
+// does not start after b
+func touches(a, b Range) bool {
+	if a.Overlaps(b) {
+		return true
+	}
+	return !a.End.IsZero() && !b.Start.IsZero() && a.End.AddDays(1).Equals(b.Start)
+}
+
+// normalizeRangeSet sorts the given ranges by Start and merges any
+// that overlap or are adjacent
+func normalizeRangeSet(set RangeSet) RangeSet {
+	filtered := make(RangeSet, 0, len(set))
+	for _, term := range set {
+		if !term.IsEmpty() {
+			filtered = append(filtered, term)
+		}
+	}
+	if len(filtered) == 0 {
+		return nil
+	}
+
+	sort.Slice(filtered, func(i, j int) bool {
+		return startBefore(filtered[i].Start, filtered[j].Start)
+	})
+
+	normalized := RangeSet{filtered[0]}
+	for _, next := range filtered[1:] {
+		last := normalized[len(normalized)-1]
+		if touches(last, next) {
+			normalized[len(normalized)-1] = last.Union(next)
+		} else {
+			normalized = append(normalized, next)
+		}
+	}
+	return normalized
+}
+
+// NewRangeSet creates a RangeSet out of the given Ranges, merging any
+// that overlap or are adjacent
+func NewRangeSet(terms ...Range) RangeSet {
+	return normalizeRangeSet(append(RangeSet{}, terms...))
+}
+
+// Add returns a new RangeSet with the given Range added, merging it
+// with any existing Range it overlaps or touches
+func (set RangeSet) Add(term Range) RangeSet {
+	if term.IsEmpty() {
+		return set
+	}
+	return normalizeRangeSet(append(append(RangeSet{}, set...), term))
+}
+
+// Remove returns a new RangeSet with the given Range removed from
+// every member it overlaps
+func (set RangeSet) Remove(term Range) RangeSet {
+	if term.IsEmpty() {
+		return set
+	}
+	var next RangeSet
+	for _, member := range set {
+		next = append(next, member.Difference(term)...)
+	}
+	return next
+}
+
+// ContainsDate returns true if the given Date falls within any Range
+// in the set
+func (set RangeSet) ContainsDate(date Date) bool {
+	for _, member := range set {
+		if date.Within(member) {
+			return true
+		}
+	}
+	return false
+}
+
+// Contains returns true if the given Range is entirely within a
+// single member of the set
+func (set RangeSet) Contains(term Range) bool {
+	for _, member := range set {
+		if member.Contains(term) {
+			return true
+		}
+	}
+	return false
+}
+
+// Union returns the set of days in either RangeSet
+func (set RangeSet) Union(other RangeSet) RangeSet {
+	return normalizeRangeSet(append(append(RangeSet{}, set...), other...))
+}
+
+// Intersection returns the set of days in both RangeSets
+func (set RangeSet) Intersection(other RangeSet) RangeSet {
+	var result RangeSet
+	for _, a := range set {
+		for _, b := range other {
+			if i := a.Intersection(b); !i.IsEmpty() {
+				result = append(result, i)
+			}
+		}
+	}
+	return normalizeRangeSet(result)
+}
+
+// Difference returns the days in the set that are not in other
+func (set RangeSet) Difference(other RangeSet) RangeSet {
+	result := set
+	for _, term := range other {
+		result = result.Remove(term)
+	}
+	return result
+}
+
+// SymmetricDifference returns the days that are in the set or other,
+// but not both
+func (set RangeSet) SymmetricDifference(other RangeSet) RangeSet {
+	return set.Difference(other).Union(other.Difference(set))
+}
+
+// Difference returns the parts of term that are not covered by other,
+// as a RangeSet of zero, one, or two Ranges
+func (term Range) Difference(other Range) RangeSet {
+	if term.IsEmpty() {
+		return nil
+	}
+	if other.IsEmpty() || !term.Overlaps(other) {
+		return RangeSet{term}
+	}
+
+	var result RangeSet
+	if startBefore(term.Start, other.Start) {
+		result = append(result, Range{Start: term.Start, End: other.Start.AddDays(-1)})
+	}
+	if endAfter(term.End, other.End) {
+		result = append(result, Range{Start: other.End.AddDays(1), End: term.End})
+	}
+	return result
+}
+
+// Gap returns the Range of days strictly between term and other. If
+// the two ranges overlap, touch, or either is empty, Gap returns
+// Empty()
+func (term Range) Gap(other Range) Range {
+	if term.IsEmpty() || other.IsEmpty() || term.Overlaps(other) {
+		return Empty()
+	}
+
+	var gap Range
+	switch {
+	case !term.End.IsZero() && !other.Start.IsZero() && term.End.Before(other.Start):
+		gap = Range{Start: term.End.AddDays(1), End: other.Start.AddDays(-1)}
+	case !other.End.IsZero() && !term.Start.IsZero() && other.End.Before(term.Start):
+		gap = Range{Start: other.End.AddDays(1), End: term.Start.AddDays(-1)}
+	default:
+		// Both ranges are unbounded on the sides that face each
+		// other, so there is no finite gap to express
+		return Empty()
+	}
+
+	if gap.Start.After(gap.End) {
+		// Adjacent ranges have no gap
+		return Empty()
+	}
+	return gap
+}
@@ -0,0 +1,161 @@
+package date
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRange_Difference(t *testing.T) {
+	year := EntireYear(2015)
+
+	// Removing a month from the middle splits into two ranges
+	diff := year.Difference(nov)
+	if len(diff) != 2 {
+		t.Fatalf("Difference of 2015 and November should yield 2 ranges, got %d", len(diff))
+	}
+	if diff[0] != NewRange(year.Start, nov.Start.AddDays(-1)) {
+		t.Errorf("Unexpected left remainder: %v", diff[0])
+	}
+	if diff[1] != NewRange(nov.End.AddDays(1), year.End) {
+		t.Errorf("Unexpected right remainder: %v", diff[1])
+	}
+
+	// Removing a non-overlapping range changes nothing
+	if d := year.Difference(jan); len(d) != 1 || d[0] != year {
+		t.Errorf("Difference of a non-overlapping range should return the original range, got %v", d)
+	}
+
+	// Removing everything leaves nothing
+	if d := year.Difference(year); len(d) != 0 {
+		t.Errorf("Difference of a range with itself should be empty, got %v", d)
+	}
+
+	// Removing an unbounded range from the left leaves the tail
+	if d := year.Difference(untilDec); len(d) != 1 || d[0] != NewRange(nov.End.AddDays(1), year.End) {
+		t.Errorf("Unexpected difference with an unbounded range: %v", d)
+	}
+}
+
+func TestRange_Gap(t *testing.T) {
+	if g := nov.Gap(jan); g != NewRange(nov.End.AddDays(1), jan.Start.AddDays(-1)) {
+		t.Errorf("Gap between November 2015 and January 2016 should be December 2015, got %v", g)
+	}
+	if g := jan.Gap(nov); g != NewRange(nov.End.AddDays(1), jan.Start.AddDays(-1)) {
+		t.Errorf("Gap should be symmetric, got %v", g)
+	}
+	if g := nov.Gap(dec); !g.IsEmpty() {
+		t.Errorf("Adjacent ranges should have no gap, got %v", g)
+	}
+	if g := nov.Gap(year2015); !g.IsEmpty() {
+		t.Errorf("Overlapping ranges should have no gap, got %v", g)
+	}
+}
+
+func TestRangeSet_Add(t *testing.T) {
+	set := NewRangeSet(jan).Add(feb)
+	if len(set) != 1 {
+		t.Fatalf("Adjacent ranges should merge into 1, got %d", len(set))
+	}
+	if set[0] != NewRange(jan.Start, feb.End) {
+		t.Errorf("Unexpected merged range: %v", set[0])
+	}
+
+	disjoint := NewRangeSet(nov).Add(jan)
+	if len(disjoint) != 2 {
+		t.Fatalf("Disjoint ranges should not merge, got %d", len(disjoint))
+	}
+}
+
+func TestRangeSet_Remove(t *testing.T) {
+	set := NewRangeSet(year2015).Remove(nov)
+	if len(set) != 2 {
+		t.Fatalf("Removing November from 2015 should leave 2 ranges, got %d", len(set))
+	}
+	if set.ContainsDate(New(2015, 11, 15)) {
+		t.Error("The set should no longer contain a day in November")
+	}
+	if !set.ContainsDate(New(2015, 10, 15)) {
+		t.Error("The set should still contain a day in October")
+	}
+}
+
+func TestRangeSet_Contains(t *testing.T) {
+	set := NewRangeSet(year2015)
+	if !set.Contains(nov) {
+		t.Error("2015 should contain November 2015")
+	}
+	if set.Contains(jan) {
+		t.Error("2015 should not contain January 2016")
+	}
+}
+
+func TestRangeSet_Union(t *testing.T) {
+	a := NewRangeSet(nov)
+	b := NewRangeSet(jan)
+	union := a.Union(b)
+	if len(union) != 2 {
+		t.Fatalf("Union of disjoint sets should have 2 ranges, got %d", len(union))
+	}
+
+	merged := NewRangeSet(nov).Union(NewRangeSet(dec))
+	if len(merged) != 1 {
+		t.Fatalf("Union of adjacent sets should merge into 1 range, got %d", len(merged))
+	}
+}
+
+func TestRangeSet_Intersection(t *testing.T) {
+	a := NewRangeSet(year2015)
+	b := NewRangeSet(nov, jan)
+	intersect := a.Intersection(b)
+	if len(intersect) != 1 || intersect[0] != nov {
+		t.Errorf("Intersection of 2015 with {November 2015, January 2016} should be November 2015, got %v", intersect)
+	}
+}
+
+func TestRangeSet_Difference(t *testing.T) {
+	a := NewRangeSet(year2015)
+	b := NewRangeSet(nov)
+	diff := a.Difference(b)
+	if diff.ContainsDate(New(2015, 11, 15)) {
+		t.Error("Difference should not contain a day removed by the subtracted set")
+	}
+	if !diff.ContainsDate(New(2015, 10, 15)) {
+		t.Error("Difference should still contain days outside the subtracted set")
+	}
+}
+
+func TestRangeSet_SymmetricDifference(t *testing.T) {
+	a := NewRangeSet(year2015)
+	b := NewRangeSet(nov, jan)
+
+	sym := a.SymmetricDifference(b)
+	// November is in both, so it should be excluded
+	if sym.ContainsDate(New(2015, 11, 15)) {
+		t.Error("SymmetricDifference should exclude days present in both sets")
+	}
+	// October is only in a, so it should remain
+	if !sym.ContainsDate(New(2015, 10, 15)) {
+		t.Error("SymmetricDifference should keep days only present in one set")
+	}
+	// January 2016 is only in b, so it should remain
+	if !sym.ContainsDate(New(2016, 1, 15)) {
+		t.Error("SymmetricDifference should keep days only present in one set")
+	}
+}
+
+func TestRangeSet_JSON(t *testing.T) {
+	set := NewRangeSet(nov, jan)
+
+	output, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal("json.Marshal of a RangeSet should not error")
+	}
+
+	var parsed RangeSet
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		t.Fatal("json.Unmarshal of a RangeSet should not error")
+	}
+	if len(parsed) != len(set) || parsed[0] != set[0] || parsed[1] != set[1] {
+		t.Errorf("RangeSet should round-trip through JSON, got %v", parsed)
+	}
+}
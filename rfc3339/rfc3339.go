@@ -0,0 +1,47 @@
+// Package rfc3339 provides a Date that also accepts full RFC 3339
+// timestamps when unmarshaling JSON, discarding the time-of-day. This
+// matches feeds - such as the CISA KEV catalog - whose date fields are
+// sometimes emitted as full timestamps instead of a bare date.
+package rfc3339
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	"github.com/aodin/date"
+)
+
+// Date embeds date.Date, overriding only its JSON unmarshaling
+type Date struct {
+	date.Date
+}
+
+// UnmarshalJSON accepts either "2006-01-02" or a full RFC 3339
+// timestamp, discarding the time-of-day in the latter case
+func (d *Date) UnmarshalJSON(text []byte) error {
+	if string(text) == "null" {
+		var zero Date
+		*d = zero
+		return nil
+	}
+
+	b := bytes.NewBuffer(text)
+	dec := json.NewDecoder(b)
+	var s string
+	if err := dec.Decode(&s); err != nil {
+		return err
+	}
+
+	if parsed, err := date.Parse(s); err == nil {
+		d.Date = parsed
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return err
+	}
+	d.Date = date.FromTime(t)
+	return nil
+}
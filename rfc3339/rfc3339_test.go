@@ -0,0 +1,51 @@
+package rfc3339
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aodin/date"
+)
+
+func TestDate_UnmarshalJSON(t *testing.T) {
+	var d Date
+	if err := json.Unmarshal([]byte(`"2015-03-01"`), &d); err != nil {
+		t.Fatal("Unmarshal of a bare date should not error")
+	}
+	if !d.Equals(date.New(2015, 3, 1)) {
+		t.Error("Unmarshal of a bare date should produce the expected date")
+	}
+
+	var fromTimestamp Date
+	raw := `"2015-03-01T13:45:30Z"`
+	if err := json.Unmarshal([]byte(raw), &fromTimestamp); err != nil {
+		t.Fatal("Unmarshal of an RFC 3339 timestamp should not error")
+	}
+	if !fromTimestamp.Equals(date.New(2015, 3, 1)) {
+		t.Error("Unmarshal of an RFC 3339 timestamp should discard the time-of-day")
+	}
+
+	var zero Date
+	if err := json.Unmarshal([]byte("null"), &zero); err != nil {
+		t.Fatal("Unmarshal of null should not error")
+	}
+	if !zero.IsZero() {
+		t.Error("Unmarshal of null should produce a zero date")
+	}
+
+	var invalid Date
+	if json.Unmarshal([]byte(`"not-a-date"`), &invalid) == nil {
+		t.Error("Unmarshal of an invalid string should error")
+	}
+}
+
+func TestDate_MarshalJSON(t *testing.T) {
+	d := Date{Date: date.New(2015, 3, 1)}
+	output, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal("Marshal should not error")
+	}
+	if string(output) != `"2015-03-01"` {
+		t.Errorf(`Marshal should produce "2015-03-01", got %s`, output)
+	}
+}